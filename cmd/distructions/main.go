@@ -0,0 +1,171 @@
+// Command distructions is the CLI entry point: it launches the interactive
+// Bubble Tea command picker by default, or runs one of the scriptable
+// subcommands below.
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/spf13/cobra"
+
+	"github.com/othernotherone/distructions/pkg/config"
+	"github.com/othernotherone/distructions/pkg/detect"
+	"github.com/othernotherone/distructions/pkg/streams"
+	"github.com/othernotherone/distructions/pkg/tui"
+)
+
+func main() {
+	s := streams.NewStandard()
+	if err := newRootCmd(s).Execute(); err != nil {
+		fmt.Fprintf(s.Err, "Error: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+// detectors converts the built-in detect.Detectors registry to
+// []config.Detector, the interface pkg/config actually depends on.
+func detectors() []config.Detector {
+	converted := make([]config.Detector, len(detect.Detectors))
+	for i, d := range detect.Detectors {
+		converted[i] = d
+	}
+	return converted
+}
+
+func newRootCmd(s streams.Streams) *cobra.Command {
+	root := &cobra.Command{
+		Use:   "distructions",
+		Short: "Interactive launcher for project commands",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runTUI(s)
+		},
+	}
+
+	root.AddCommand(newRunCmd(s))
+	root.AddCommand(newListCmd(s))
+	root.AddCommand(newGenerateCmd(s))
+	root.AddCommand(newInitCmd(s))
+
+	return root
+}
+
+func runTUI(s streams.Streams) error {
+	needsGenerate, err := config.Bootstrap(s)
+	if err != nil {
+		switch err.Error() {
+		case "config generation cancelled by user":
+			fmt.Fprintln(s.Out, "Cancelled by user. Run 'distructions' again if you change your mind!")
+			return nil
+		case "not a git repository":
+			fmt.Fprintln(s.Out, "Not a git repository. Distructions only works in git repositories.")
+			return nil
+		default:
+			return err
+		}
+	}
+
+	var model tui.Model
+	if needsGenerate {
+		// Let the TUI itself run the (parallel, per-detector) generation so
+		// the user sees live progress instead of staring at a blank screen.
+		model = tui.NewGeneratingModel(config.NewGenerator(".", detectors()...), s)
+	} else {
+		cfg, err := config.ReadLocal()
+		model = tui.NewModel(cfg, err, s)
+	}
+
+	p := tea.NewProgram(model, tea.WithInput(s.In), tea.WithOutput(s.Out))
+	_, err = p.Run()
+	return err
+}
+
+func newRunCmd(s streams.Streams) *cobra.Command {
+	return &cobra.Command{
+		Use:   "run <name>",
+		Short: "Run a command by name, non-interactively",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cfg, err := config.Load(detectors()...)
+			if err != nil {
+				return err
+			}
+
+			name := args[0]
+			for _, c := range cfg.Commands {
+				if c.Name != name {
+					continue
+				}
+				run := exec.Command("sh", "-c", c.Command)
+				run.Stdout = s.Out
+				run.Stderr = s.Err
+				run.Stdin = s.In
+				return run.Run()
+			}
+
+			return fmt.Errorf("no command named %q", name)
+		},
+	}
+}
+
+func newListCmd(s streams.Streams) *cobra.Command {
+	return &cobra.Command{
+		Use:   "list",
+		Short: "Print the available commands as JSON",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cfg, err := config.Load(detectors()...)
+			if err != nil {
+				return err
+			}
+
+			data, err := json.MarshalIndent(cfg.Commands, "", "    ")
+			if err != nil {
+				return err
+			}
+			fmt.Fprintln(s.Out, string(data))
+			return nil
+		},
+	}
+}
+
+func newGenerateCmd(s streams.Streams) *cobra.Command {
+	return &cobra.Command{
+		Use:   "generate",
+		Short: "Force regenerate .project-commands.json",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			generator := config.NewGenerator(".", detectors()...)
+			if err := generator.Generate(true); err != nil {
+				return err
+			}
+			fmt.Fprintln(s.Out, "Generated .project-commands.json")
+			return nil
+		},
+	}
+}
+
+func newInitCmd(s streams.Streams) *cobra.Command {
+	return &cobra.Command{
+		Use:   "init",
+		Short: "Create an empty .project-commands.json",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if _, err := os.Stat(".project-commands.json"); err == nil {
+				return fmt.Errorf(".project-commands.json already exists")
+			}
+
+			cfg := config.Config{Commands: []config.Command{}}
+			data, err := json.MarshalIndent(cfg, "", "    ")
+			if err != nil {
+				return err
+			}
+			if err := os.WriteFile(".project-commands.json", data, 0644); err != nil {
+				return err
+			}
+
+			fmt.Fprintln(s.Out, "Created empty .project-commands.json")
+			return nil
+		},
+	}
+}
@@ -0,0 +1,143 @@
+// Package config owns the project configuration format
+// (.project-commands.json), loading it (including remote "extends"
+// resolution) and regenerating it via the detector-driven Generator.
+package config
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/othernotherone/distructions/pkg/remote"
+	"github.com/othernotherone/distructions/pkg/streams"
+)
+
+// Command represents a project command with description
+type Command struct {
+	Name        string   `json:"name"`
+	Command     string   `json:"command"`
+	Description string   `json:"description"`
+	Group       string   `json:"group,omitempty"`
+	Profiles    []string `json:"profiles,omitempty"`
+}
+
+// Config represents the project configuration
+type Config struct {
+	ProjectName string    `json:"projectName"`
+	Commands    []Command `json:"commands"`
+	Extends     string    `json:"extends,omitempty"`
+}
+
+const filename = ".project-commands.json"
+
+// Load reads the project configuration, generating it first if it doesn't
+// exist yet, and merging in a remote "extends" source when the config
+// declares one. Unlike the interactive TUI's use of Bootstrap, Load never
+// prompts: it's used by the scriptable `run`/`list` subcommands, which need
+// to work unattended in CI against a fresh checkout. detectors is forwarded
+// to the Generator if generation is needed; callers typically pass
+// detect.Detectors converted to []config.Detector.
+func Load(detectors ...Detector) (Config, error) {
+	if _, err := os.Stat(".git"); err != nil {
+		return Config{}, fmt.Errorf("not a git repository")
+	}
+
+	if _, err := os.Stat(filename); err != nil {
+		generator := NewGenerator(".", detectors...)
+		if err := generator.Generate(false); err != nil {
+			return Config{}, err
+		}
+	}
+
+	return ReadLocal()
+}
+
+// Bootstrap checks the repo/config preconditions Load and the interactive
+// TUI both need: that we're in a git repository, and that a config file
+// exists or the user (prompted over s) consents to generating one. It
+// reports whether the caller still needs to run a Generator before the
+// config file can be read - used by the TUI to show live generation
+// progress instead of generating synchronously before it even starts.
+func Bootstrap(s streams.Streams) (needsGenerate bool, err error) {
+	if _, err := os.Stat(".git"); err != nil {
+		return false, fmt.Errorf("not a git repository")
+	}
+
+	if _, err := os.Stat(filename); err == nil {
+		return false, nil
+	}
+
+	if !promptUser(s, "No .project-commands.json found. Would you like to generate one?") {
+		return false, fmt.Errorf("config generation cancelled by user")
+	}
+
+	return true, nil
+}
+
+// ReadLocal reads .project-commands.json from disk, resolving and merging
+// in a remote "extends" source when the config declares one. Unlike Load,
+// it assumes the file already exists (e.g. because a Generator just wrote
+// it) and never prompts or generates.
+func ReadLocal() (Config, error) {
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		return Config{}, err
+	}
+
+	var config Config
+	if err := json.Unmarshal(data, &config); err != nil {
+		return Config{}, err
+	}
+
+	if config.Extends == "" {
+		return config, nil
+	}
+
+	remoteData, err := remote.Resolve(config.Extends)
+	if err != nil {
+		return Config{}, fmt.Errorf("resolving extends %q: %w", config.Extends, err)
+	}
+
+	var base Config
+	if err := json.Unmarshal(remoteData, &base); err != nil {
+		return Config{}, fmt.Errorf("parsing extends %q: %w", config.Extends, err)
+	}
+
+	return mergeConfig(base, config), nil
+}
+
+// mergeConfig layers local on top of base: local commands override a base
+// command of the same name, and any command only present locally is kept
+// as-is. base supplies the project name when local didn't set one.
+func mergeConfig(base, local Config) Config {
+	merged := Config{ProjectName: base.ProjectName}
+	if local.ProjectName != "" {
+		merged.ProjectName = local.ProjectName
+	}
+
+	byName := make(map[string]int)
+	for _, cmd := range base.Commands {
+		byName[cmd.Name] = len(merged.Commands)
+		merged.Commands = append(merged.Commands, cmd)
+	}
+
+	for _, cmd := range local.Commands {
+		if i, ok := byName[cmd.Name]; ok {
+			merged.Commands[i] = cmd
+			continue
+		}
+		merged.Commands = append(merged.Commands, cmd)
+	}
+
+	return merged
+}
+
+func promptUser(s streams.Streams, message string) bool {
+	reader := bufio.NewReader(s.In)
+	fmt.Fprintf(s.Out, "\n%s (y/n): ", message)
+	response, _ := reader.ReadString('\n')
+	response = strings.ToLower(strings.TrimSpace(response))
+	return response == "y" || response == "yes"
+}
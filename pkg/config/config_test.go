@@ -0,0 +1,55 @@
+package config
+
+import "testing"
+
+func TestMergeConfig(t *testing.T) {
+	base := Config{
+		ProjectName: "base-project",
+		Commands: []Command{
+			{Name: "build", Command: "make build", Description: "base build"},
+			{Name: "test", Command: "make test", Description: "base test"},
+		},
+	}
+
+	local := Config{
+		Commands: []Command{
+			{Name: "build", Command: "make build --fast", Description: "local build override"},
+			{Name: "lint", Command: "make lint", Description: "local-only command"},
+		},
+	}
+
+	merged := mergeConfig(base, local)
+
+	if merged.ProjectName != "base-project" {
+		t.Errorf("ProjectName = %q, want %q (local left it unset)", merged.ProjectName, "base-project")
+	}
+
+	byName := make(map[string]Command)
+	for _, c := range merged.Commands {
+		byName[c.Name] = c
+	}
+
+	if got := byName["build"].Command; got != "make build --fast" {
+		t.Errorf("build command = %q, want local override %q", got, "make build --fast")
+	}
+	if got := byName["test"].Command; got != "make test" {
+		t.Errorf("test command = %q, want base command kept as-is", got)
+	}
+	if _, ok := byName["lint"]; !ok {
+		t.Error("lint command from local was dropped, want it kept")
+	}
+	if len(merged.Commands) != 3 {
+		t.Errorf("len(merged.Commands) = %d, want 3", len(merged.Commands))
+	}
+}
+
+func TestMergeConfigLocalProjectNameWins(t *testing.T) {
+	base := Config{ProjectName: "base-project"}
+	local := Config{ProjectName: "local-project"}
+
+	merged := mergeConfig(base, local)
+
+	if merged.ProjectName != "local-project" {
+		t.Errorf("ProjectName = %q, want %q", merged.ProjectName, "local-project")
+	}
+}
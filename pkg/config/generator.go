@@ -0,0 +1,179 @@
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/othernotherone/distructions/pkg/log"
+)
+
+// Detector inspects a project root and returns the commands it can offer.
+// This mirrors pkg/detect.Detector exactly so that package's built-ins
+// satisfy this interface structurally, without pkg/config importing
+// pkg/detect (which would create an import cycle, since pkg/detect needs
+// the Command type defined in config.go).
+type Detector interface {
+	Name() string
+	Detect(root string) ([]Command, error)
+}
+
+// Generator handles detecting and generating config
+type Generator struct {
+	projectRoot string
+	detectors   []Detector
+}
+
+// NewGenerator returns a Generator rooted at projectRoot. Callers wire in
+// the detectors to run, typically detect.Detectors converted to
+// []config.Detector, so pkg/config never needs to import pkg/detect.
+func NewGenerator(projectRoot string, detectors ...Detector) *Generator {
+	return &Generator{
+		projectRoot: projectRoot,
+		detectors:   detectors,
+	}
+}
+
+// Progress is a single human-readable status line about one detector's
+// scan, streamed over the channel passed to GenerateWithProgress.
+type Progress struct {
+	Detector string
+	Message  string
+}
+
+// Generate writes .project-commands.json from the registered detectors. If
+// force is false and the file already exists, Generate is a no-op.
+func (g *Generator) Generate(force bool) error {
+	return g.GenerateWithProgress(force, nil)
+}
+
+// detectorResult is what a detector goroutine reports back over the results
+// channel once its Detect call returns.
+type detectorResult struct {
+	name     string
+	commands []Command
+	err      error
+}
+
+// GenerateWithProgress is Generate, but runs every detector concurrently
+// and reports each one's status ("scanning make... found 4 commands") on
+// progress as it completes, so a caller like the TUI can show a live list
+// during first-run generation. progress may be nil.
+func (g *Generator) GenerateWithProgress(force bool, progress chan<- Progress) error {
+	if !force {
+		if _, err := os.Stat(filename); err == nil {
+			if progress != nil {
+				close(progress)
+			}
+			return nil // Config already exists
+		}
+	}
+
+	results := make(chan detectorResult)
+	var wg sync.WaitGroup
+
+	for _, detector := range g.detectors {
+		wg.Add(1)
+		go func(d Detector) {
+			defer wg.Done()
+			report(progress, d.Name(), fmt.Sprintf("scanning %s...", d.Name()))
+			commands, err := d.Detect(g.projectRoot)
+			results <- detectorResult{name: d.Name(), commands: commands, err: err}
+		}(detector)
+	}
+
+	go func() {
+		wg.Wait()
+		close(results)
+	}()
+
+	type tagged struct {
+		detector string
+		command  Command
+	}
+	var tallied []tagged
+
+	for res := range results {
+		if res.err != nil {
+			log.Warnf("generate: detector %q failed: %v", res.name, res.err)
+			report(progress, res.name, fmt.Sprintf("%s: error: %v", res.name, res.err))
+			continue
+		}
+		log.Debugf("generate: detector %q found %d commands", res.name, len(res.commands))
+		report(progress, res.name, fmt.Sprintf("%s: found %d commands", res.name, len(res.commands)))
+		for _, cmd := range res.commands {
+			tallied = append(tallied, tagged{detector: res.name, command: cmd})
+		}
+	}
+
+	// Detectors finish in whatever order their goroutines happen to, so
+	// sort by (detector, command name) to keep the generated file stable
+	// across runs.
+	sort.Slice(tallied, func(i, j int) bool {
+		if tallied[i].detector != tallied[j].detector {
+			return tallied[i].detector < tallied[j].detector
+		}
+		return tallied[i].command.Name < tallied[j].command.Name
+	})
+
+	config := Config{
+		ProjectName: getRepoName(),
+		Commands:    []Command{},
+	}
+	for _, t := range tallied {
+		config.Commands = append(config.Commands, t.command)
+	}
+
+	if progress != nil {
+		close(progress)
+	}
+
+	if len(config.Commands) > 0 {
+		return g.saveConfig(config)
+	}
+
+	return nil
+}
+
+func report(progress chan<- Progress, detector, message string) {
+	if progress == nil {
+		return
+	}
+	progress <- Progress{Detector: detector, Message: message}
+}
+
+func (g *Generator) saveConfig(config Config) error {
+	data, err := json.MarshalIndent(config, "", "    ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(filename, data, 0644)
+}
+
+func getRepoName() string {
+	// Try to get the remote origin URL
+	cmd := exec.Command("git", "config", "--get", "remote.origin.url")
+	output, err := cmd.Output()
+	if err == nil {
+		// Clean the URL and get the last part
+		url := strings.TrimSpace(string(output))
+		url = strings.TrimSuffix(url, ".git")
+		parts := strings.Split(url, "/")
+		if len(parts) > 0 {
+			return parts[len(parts)-1]
+		}
+	}
+
+	// Fallback: try to get the directory name
+	dir, err := os.Getwd()
+	if err == nil {
+		return filepath.Base(dir)
+	}
+
+	return "Unknown Project"
+}
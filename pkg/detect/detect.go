@@ -0,0 +1,520 @@
+package detect
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/othernotherone/distructions/pkg/config"
+	"github.com/othernotherone/distructions/pkg/log"
+)
+
+// Detector inspects a project root and returns the commands it can offer.
+// Implementations should fail soft: a missing or unreadable file means "no
+// commands", not an error that aborts the rest of generation.
+type Detector interface {
+	Name() string
+	Detect(root string) ([]config.Command, error)
+}
+
+// Detectors is the set of built-in detectors. Callers (typically
+// cmd/distructions, wiring up a config.Generator) convert this to
+// []config.Detector; register additional detectors here.
+var Detectors = []Detector{
+	nodeDetector{},
+	dockerDetector{},
+	goDetector{},
+	makefileDetector{},
+	taskRunnerDetector{},
+	cargoDetector{},
+	pythonDetector{},
+	jvmDetector{},
+}
+
+type PackageJSON struct {
+	Scripts map[string]string `json:"scripts"`
+}
+
+type nodeDetector struct{}
+
+func (nodeDetector) Name() string { return "node" }
+
+func (nodeDetector) Detect(root string) ([]config.Command, error) {
+	data, err := os.ReadFile(joinRoot(root, "package.json"))
+	if err != nil {
+		log.Debugf("node: skipping, no package.json: %v", err)
+		return nil, nil
+	}
+
+	var pkg PackageJSON
+	if err := json.Unmarshal(data, &pkg); err != nil {
+		log.Warnf("node: failed to parse package.json: %v", err)
+		return nil, nil
+	}
+
+	var commands []config.Command
+	for name, script := range pkg.Scripts {
+		commands = append(commands, config.Command{
+			Name:        fmt.Sprintf("npm: %s", name),
+			Command:     fmt.Sprintf("npm run %s", name),
+			Description: fmt.Sprintf("Run npm script: %s", script),
+		})
+	}
+	return commands, nil
+}
+
+type dockerDetector struct{}
+
+func (dockerDetector) Name() string { return "docker" }
+
+func (dockerDetector) Detect(root string) ([]config.Command, error) {
+	files := []string{"docker-compose.yml", "docker-compose.yaml"}
+	var composeFile string
+
+	for _, file := range files {
+		if _, err := os.Stat(joinRoot(root, file)); err == nil {
+			composeFile = file
+			break
+		}
+	}
+
+	if composeFile == "" {
+		log.Debugf("docker: skipping, no docker-compose.yml found")
+		return nil, nil
+	}
+
+	data, err := os.ReadFile(joinRoot(root, composeFile))
+	if err != nil {
+		log.Warnf("docker: failed to read %s: %v", composeFile, err)
+		return nil, nil
+	}
+
+	var compose map[string]interface{}
+	if err := yaml.Unmarshal(data, &compose); err != nil {
+		log.Warnf("docker: failed to parse %s: %v", composeFile, err)
+		return nil, nil
+	}
+
+	services, ok := compose["services"].(map[string]interface{})
+	if !ok {
+		log.Debugf("docker: %s has no services block", composeFile)
+		return nil, nil
+	}
+
+	commands := []config.Command{
+		{
+			Name:        "Docker: Start All",
+			Command:     "docker-compose up",
+			Description: "Start all Docker containers",
+		},
+		{
+			Name:        "Docker: Start All (Detached)",
+			Command:     "docker-compose up -d",
+			Description: "Start all Docker containers in detached mode",
+		},
+		{
+			Name:        "Docker: Stop All",
+			Command:     "docker-compose down",
+			Description: "Stop all Docker containers",
+		},
+	}
+
+	for serviceName, raw := range services {
+		profiles := serviceProfiles(raw)
+
+		lifecycle := []struct {
+			suffix      string
+			args        string
+			description string
+		}{
+			{"Start", "up %s", "Start the %s service"},
+			{"Start (Detached)", "up -d %s", "Start the %s service in detached mode"},
+			{"Stop", "stop %s", "Stop the %s service"},
+			{"Restart", "restart %s", "Restart the %s service"},
+			{"Logs", "logs -f %s", "Tail logs for the %s service"},
+			{"Exec Shell", "exec %s sh", "Open a shell in the %s service"},
+			{"Pull", "pull %s", "Pull the image for the %s service"},
+			{"Build", "build %s", "Build the %s service"},
+		}
+
+		for _, lc := range lifecycle {
+			commands = append(commands, config.Command{
+				Name:        fmt.Sprintf("Docker: %s %s", lc.suffix, serviceName),
+				Command:     fmt.Sprintf("docker-compose "+lc.args, serviceName),
+				Description: fmt.Sprintf(lc.description, serviceName),
+				Group:       serviceName,
+				Profiles:    profiles,
+			})
+		}
+	}
+
+	return commands, nil
+}
+
+// serviceProfiles reads the `profiles:` list of a single Compose service
+// entry, as understood by the Compose spec/CLI.
+func serviceProfiles(service interface{}) []string {
+	serviceMap, ok := service.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	raw, ok := serviceMap["profiles"].([]interface{})
+	if !ok {
+		return nil
+	}
+
+	var profiles []string
+	for _, p := range raw {
+		if s, ok := p.(string); ok {
+			profiles = append(profiles, s)
+		}
+	}
+	return profiles
+}
+
+type goDetector struct{}
+
+func (goDetector) Name() string { return "go" }
+
+func (goDetector) Detect(root string) ([]config.Command, error) {
+	if _, err := os.Stat(joinRoot(root, "go.mod")); err != nil {
+		log.Debugf("go: skipping, no go.mod: %v", err)
+		return nil, nil
+	}
+
+	return []config.Command{
+		{
+			Name:        "Go: Run",
+			Command:     "go run .",
+			Description: "Run the Go application",
+		},
+		{
+			Name:        "Go: Test",
+			Command:     "go test ./...",
+			Description: "Run all tests",
+		},
+		{
+			Name:        "Go: Build",
+			Command:     "go build",
+			Description: "Build the Go application",
+		},
+	}, nil
+}
+
+// makefileTargetPattern matches a Make rule target line, e.g. "build:" or
+// "build: deps". Pattern rules (containing "%"), the phony declaration
+// itself, and variable assignments (e.g. "CC:=gcc", which also matches up
+// to the first ":") are skipped below.
+var makefileTargetPattern = regexp.MustCompile(`^([a-zA-Z0-9_.-]+):`)
+
+type makefileDetector struct{}
+
+func (makefileDetector) Name() string { return "make" }
+
+func (makefileDetector) Detect(root string) ([]config.Command, error) {
+	data, err := os.ReadFile(joinRoot(root, "Makefile"))
+	if err != nil {
+		log.Debugf("make: skipping, no Makefile: %v", err)
+		return nil, nil
+	}
+
+	var commands []config.Command
+	for _, line := range strings.Split(string(data), "\n") {
+		match := makefileTargetPattern.FindStringSubmatch(line)
+		if match == nil {
+			continue
+		}
+		if strings.HasPrefix(line[len(match[0]):], "=") {
+			continue // compact variable assignment, e.g. "CC:=gcc"
+		}
+		target := match[1]
+		if target == ".PHONY" || strings.Contains(target, "%") {
+			continue
+		}
+		commands = append(commands, config.Command{
+			Name:        fmt.Sprintf("make: %s", target),
+			Command:     fmt.Sprintf("make %s", target),
+			Description: fmt.Sprintf("Run Makefile target: %s", target),
+		})
+	}
+	return commands, nil
+}
+
+// taskRunnerDetector covers justfile and Taskfile.yml, both of which keep
+// their runnable units under a top-level "tasks" (Taskfile) or a series of
+// recipe headers (justfile).
+type taskRunnerDetector struct{}
+
+func (taskRunnerDetector) Name() string { return "taskrunner" }
+
+func (taskRunnerDetector) Detect(root string) ([]config.Command, error) {
+	var commands []config.Command
+	commands = append(commands, detectJustfile(root)...)
+	commands = append(commands, detectTaskfile(root)...)
+	return commands, nil
+}
+
+var justRecipePattern = regexp.MustCompile(`^([a-zA-Z0-9_-]+)\s*:`)
+
+func detectJustfile(root string) []config.Command {
+	data, err := os.ReadFile(joinRoot(root, "justfile"))
+	if err != nil {
+		data, err = os.ReadFile(joinRoot(root, "Justfile"))
+		if err != nil {
+			log.Debugf("taskrunner: skipping, no justfile: %v", err)
+			return nil
+		}
+	}
+
+	var commands []config.Command
+	for _, line := range strings.Split(string(data), "\n") {
+		if strings.HasPrefix(line, " ") || strings.HasPrefix(line, "\t") {
+			continue // indented recipe body, not a recipe header
+		}
+		match := justRecipePattern.FindStringSubmatch(line)
+		if match == nil {
+			continue
+		}
+		recipe := match[1]
+		commands = append(commands, config.Command{
+			Name:        fmt.Sprintf("just: %s", recipe),
+			Command:     fmt.Sprintf("just %s", recipe),
+			Description: fmt.Sprintf("Run justfile recipe: %s", recipe),
+		})
+	}
+	return commands
+}
+
+func detectTaskfile(root string) []config.Command {
+	data, err := os.ReadFile(joinRoot(root, "Taskfile.yml"))
+	if err != nil {
+		data, err = os.ReadFile(joinRoot(root, "Taskfile.yaml"))
+		if err != nil {
+			log.Debugf("taskrunner: skipping, no Taskfile: %v", err)
+			return nil
+		}
+	}
+
+	var taskfile struct {
+		Tasks map[string]interface{} `yaml:"tasks"`
+	}
+	if err := yaml.Unmarshal(data, &taskfile); err != nil {
+		log.Warnf("taskrunner: failed to parse Taskfile: %v", err)
+		return nil
+	}
+
+	var commands []config.Command
+	for name := range taskfile.Tasks {
+		commands = append(commands, config.Command{
+			Name:        fmt.Sprintf("task: %s", name),
+			Command:     fmt.Sprintf("task %s", name),
+			Description: fmt.Sprintf("Run Taskfile task: %s", name),
+		})
+	}
+	return commands
+}
+
+type cargoDetector struct{}
+
+func (cargoDetector) Name() string { return "cargo" }
+
+func (cargoDetector) Detect(root string) ([]config.Command, error) {
+	data, err := os.ReadFile(joinRoot(root, "Cargo.toml"))
+	if err != nil {
+		log.Debugf("cargo: skipping, no Cargo.toml: %v", err)
+		return nil, nil
+	}
+	contents := string(data)
+
+	commands := []config.Command{
+		{
+			Name:        "Cargo: Run",
+			Command:     "cargo run",
+			Description: "Build and run the crate",
+		},
+		{
+			Name:        "Cargo: Build",
+			Command:     "cargo build",
+			Description: "Build the crate",
+		},
+		{
+			Name:        "Cargo: Test",
+			Command:     "cargo test",
+			Description: "Run the crate's tests",
+		},
+	}
+
+	for _, name := range tomlArrayTableNames(contents, "bin") {
+		commands = append(commands, config.Command{
+			Name:        fmt.Sprintf("Cargo: Run %s", name),
+			Command:     fmt.Sprintf("cargo run --bin %s", name),
+			Description: fmt.Sprintf("Build and run the %s binary target", name),
+		})
+	}
+
+	return commands, nil
+}
+
+// tomlArrayTableNames does a minimal, dependency-free scan for `name = "..."`
+// keys inside every `[[table]]` array-of-tables section, good enough for the
+// small subset of Cargo.toml/pyproject.toml shapes distructions cares about.
+func tomlArrayTableNames(contents, table string) []string {
+	header := "[[" + table + "]]"
+	nameLine := regexp.MustCompile(`^\s*name\s*=\s*"([^"]+)"`)
+
+	var names []string
+	inSection := false
+	for _, line := range strings.Split(contents, "\n") {
+		trimmed := strings.TrimSpace(line)
+		if strings.HasPrefix(trimmed, "[[") {
+			inSection = trimmed == header
+			continue
+		}
+		if strings.HasPrefix(trimmed, "[") {
+			inSection = false
+			continue
+		}
+		if !inSection {
+			continue
+		}
+		if match := nameLine.FindStringSubmatch(line); match != nil {
+			names = append(names, match[1])
+		}
+	}
+	return names
+}
+
+// tomlTableKeys returns the keys defined directly under a `[table]` section,
+// e.g. `[tool.poetry.scripts]` entries like `mycli = "mypkg.cli:main"`.
+func tomlTableKeys(contents, table string) []string {
+	header := "[" + table + "]"
+	keyLine := regexp.MustCompile(`^([a-zA-Z0-9_.-]+)\s*=`)
+
+	var keys []string
+	inSection := false
+	for _, line := range strings.Split(contents, "\n") {
+		trimmed := strings.TrimSpace(line)
+		if strings.HasPrefix(trimmed, "[") {
+			inSection = trimmed == header
+			continue
+		}
+		if !inSection {
+			continue
+		}
+		if match := keyLine.FindStringSubmatch(trimmed); match != nil {
+			keys = append(keys, match[1])
+		}
+	}
+	return keys
+}
+
+type pythonDetector struct{}
+
+func (pythonDetector) Name() string { return "python" }
+
+func (pythonDetector) Detect(root string) ([]config.Command, error) {
+	data, err := os.ReadFile(joinRoot(root, "pyproject.toml"))
+	if err != nil {
+		log.Debugf("python: skipping, no pyproject.toml: %v", err)
+		return nil, nil
+	}
+	contents := string(data)
+
+	seen := map[string]bool{}
+	var scripts []string
+	for _, key := range tomlTableKeys(contents, "tool.poetry.scripts") {
+		if !seen[key] {
+			seen[key] = true
+			scripts = append(scripts, key)
+		}
+	}
+	for _, key := range tomlTableKeys(contents, "project.scripts") {
+		if !seen[key] {
+			seen[key] = true
+			scripts = append(scripts, key)
+		}
+	}
+	sort.Strings(scripts)
+
+	var commands []config.Command
+	for _, script := range scripts {
+		commands = append(commands, config.Command{
+			Name:        fmt.Sprintf("python: %s", script),
+			Command:     script,
+			Description: fmt.Sprintf("Run console script: %s", script),
+		})
+	}
+	return commands, nil
+}
+
+// jvmDetector covers Gradle and Maven wrapper scripts, mirroring the Go
+// detector's approach of offering a fixed set of lifecycle commands once the
+// wrapper's presence confirms the build tool.
+type jvmDetector struct{}
+
+func (jvmDetector) Name() string { return "jvm" }
+
+func (jvmDetector) Detect(root string) ([]config.Command, error) {
+	var commands []config.Command
+
+	if _, err := os.Stat(joinRoot(root, "gradlew")); err == nil {
+		commands = append(commands,
+			config.Command{
+				Name:        "Gradle: Build",
+				Command:     "./gradlew build",
+				Description: "Build the project with Gradle",
+			},
+			config.Command{
+				Name:        "Gradle: Test",
+				Command:     "./gradlew test",
+				Description: "Run tests with Gradle",
+			},
+			config.Command{
+				Name:        "Gradle: Run",
+				Command:     "./gradlew run",
+				Description: "Run the project with Gradle",
+			},
+		)
+	} else {
+		log.Debugf("jvm: skipping gradle, no gradlew: %v", err)
+	}
+
+	if _, err := os.Stat(joinRoot(root, "mvnw")); err == nil {
+		commands = append(commands,
+			config.Command{
+				Name:        "Maven: Build",
+				Command:     "./mvnw package",
+				Description: "Build the project with Maven",
+			},
+			config.Command{
+				Name:        "Maven: Test",
+				Command:     "./mvnw test",
+				Description: "Run tests with Maven",
+			},
+			config.Command{
+				Name:        "Maven: Run",
+				Command:     "./mvnw exec:java",
+				Description: "Run the project with Maven",
+			},
+		)
+	} else {
+		log.Debugf("jvm: skipping maven, no mvnw: %v", err)
+	}
+
+	return commands, nil
+}
+
+// joinRoot resolves a project-relative path against root without pulling in
+// filepath.Join's OS-specific cleanup semantics for the common root=".".
+func joinRoot(root, name string) string {
+	if root == "" || root == "." {
+		return name
+	}
+	return root + "/" + name
+}
@@ -0,0 +1,92 @@
+package detect
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestTomlArrayTableNames(t *testing.T) {
+	contents := `
+[package]
+name = "mycrate"
+
+[[bin]]
+name = "server"
+
+[[bin]]
+name = "cli"
+
+[[example]]
+name = "demo"
+`
+	got := tomlArrayTableNames(contents, "bin")
+	want := []string{"server", "cli"}
+
+	if len(got) != len(want) {
+		t.Fatalf("tomlArrayTableNames() = %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("tomlArrayTableNames()[%d] = %q, want %q", i, got[i], want[i])
+		}
+	}
+}
+
+func TestMakefileDetectorDetect(t *testing.T) {
+	root := t.TempDir()
+	makefile := `CC:=gcc
+CFLAGS ?= -O2
+
+.PHONY: build
+
+build: deps
+	$(CC) $(CFLAGS) -o app main.c
+
+%.o: %.c
+	$(CC) -c $<
+`
+	if err := os.WriteFile(filepath.Join(root, "Makefile"), []byte(makefile), 0644); err != nil {
+		t.Fatalf("writing Makefile: %v", err)
+	}
+
+	commands, err := makefileDetector{}.Detect(root)
+	if err != nil {
+		t.Fatalf("Detect() error = %v", err)
+	}
+
+	var names []string
+	for _, c := range commands {
+		names = append(names, c.Name)
+	}
+
+	want := "make: build"
+	if len(names) != 1 || names[0] != want {
+		t.Errorf("Detect() targets = %v, want only %q (CC, CFLAGS, .PHONY, and %%.o should all be excluded)", names, want)
+	}
+}
+
+func TestTomlTableKeys(t *testing.T) {
+	contents := `
+[tool.poetry]
+name = "mypkg"
+
+[tool.poetry.scripts]
+mycli = "mypkg.cli:main"
+other = "mypkg.other:run"
+
+[tool.poetry.dependencies]
+python = "^3.11"
+`
+	got := tomlTableKeys(contents, "tool.poetry.scripts")
+	want := []string{"mycli", "other"}
+
+	if len(got) != len(want) {
+		t.Fatalf("tomlTableKeys() = %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("tomlTableKeys()[%d] = %q, want %q", i, got[i], want[i])
+		}
+	}
+}
@@ -0,0 +1,89 @@
+// Package log wraps logrus (as lazydocker does) so the rest of
+// distructions can log skipped files, parse errors, and command executions
+// without reaching for fmt.Println and without ever writing to the
+// terminal the Bubble Tea TUI owns.
+package log
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/sirupsen/logrus"
+)
+
+// maxLogSize is the size, in bytes, above which the existing log file is
+// rotated aside before a new one is opened.
+const maxLogSize = 5 * 1024 * 1024
+
+var logger = New()
+
+// New builds the package logger: level from LOG_LEVEL (default "info"), and
+// output to a rolling file under the user config dir, or discarded entirely
+// when LOG_LEVEL is unset so a normal run stays silent.
+func New() *logrus.Logger {
+	l := logrus.New()
+
+	levelName := os.Getenv("LOG_LEVEL")
+	if levelName == "" {
+		l.SetOutput(discardWriter{})
+		return l
+	}
+
+	level, err := logrus.ParseLevel(levelName)
+	if err != nil {
+		level = logrus.InfoLevel
+	}
+	l.SetLevel(level)
+
+	path, err := filePath()
+	if err != nil {
+		l.SetOutput(discardWriter{})
+		return l
+	}
+
+	rotateIfLarge(path)
+
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		l.SetOutput(discardWriter{})
+		return l
+	}
+	l.SetOutput(file)
+
+	return l
+}
+
+// filePath returns the log file path under the user's config dir, creating
+// the distructions subdirectory if needed.
+func filePath() (string, error) {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "", err
+	}
+
+	dir = filepath.Join(dir, "distructions")
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return "", err
+	}
+
+	return filepath.Join(dir, "distructions.log"), nil
+}
+
+// rotateIfLarge renames an existing log file aside once it crosses
+// maxLogSize, giving us basic rolling without a dedicated dependency.
+func rotateIfLarge(path string) {
+	info, err := os.Stat(path)
+	if err != nil || info.Size() < maxLogSize {
+		return
+	}
+	_ = os.Rename(path, path+".1")
+}
+
+type discardWriter struct{}
+
+func (discardWriter) Write(p []byte) (int, error) { return len(p), nil }
+
+func Debugf(format string, args ...interface{}) { logger.Debugf(format, args...) }
+func Infof(format string, args ...interface{})  { logger.Infof(format, args...) }
+func Warnf(format string, args ...interface{})  { logger.Warnf(format, args...) }
+func Errorf(format string, args ...interface{}) { logger.Errorf(format, args...) }
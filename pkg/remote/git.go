@@ -0,0 +1,68 @@
+package remote
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+)
+
+// gitLoader resolves refs shaped like
+// "git+https://github.com/org/shared-commands.git//path/commands.json",
+// optionally followed by an "@<rev>" suffix after the path, by shallow-cloning into
+// a temp dir and reading the requested path out of the checkout.
+type gitLoader struct{}
+
+func (gitLoader) Load(ref string) ([]byte, error) {
+	repoURL, rev, path, err := parseGitRef(ref)
+	if err != nil {
+		return nil, err
+	}
+
+	dir, err := os.MkdirTemp("", "distructions-git-")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(dir)
+
+	cloneArgs := []string{"clone", "--depth", "1"}
+	if rev != "" {
+		cloneArgs = append(cloneArgs, "--branch", rev)
+	}
+	cloneArgs = append(cloneArgs, repoURL, dir)
+
+	if out, err := exec.Command("git", cloneArgs...).CombinedOutput(); err != nil {
+		return nil, fmt.Errorf("git clone %s: %w: %s", repoURL, err, out)
+	}
+
+	return os.ReadFile(dir + "/" + path)
+}
+
+// parseGitRef splits "git+https://host/org/repo.git//path/to/file.json@rev"
+// into the clone URL, optional revision, and the path within the repo. The
+// repo/path separator can't just be the first "//" in the ref: for
+// "https"/"http"/"ssh" repo URLs, the scheme's own "://" contributes an
+// earlier "//" that isn't it. So the search for the separator starts after
+// the scheme, if there is one.
+func parseGitRef(ref string) (repoURL, rev, path string, err error) {
+	rest := strings.TrimPrefix(ref, "git+")
+
+	searchFrom := 0
+	if schemeEnd := strings.Index(rest, "://"); schemeEnd != -1 {
+		searchFrom = schemeEnd + len("://")
+	}
+
+	idx := strings.Index(rest[searchFrom:], "//")
+	if idx == -1 {
+		return "", "", "", fmt.Errorf("remote: git ref %q missing //<path> suffix", ref)
+	}
+	idx += searchFrom
+	repoURL, path = rest[:idx], rest[idx+2:]
+
+	if idx := strings.LastIndex(path, "@"); idx != -1 {
+		rev = path[idx+1:]
+		path = path[:idx]
+	}
+
+	return repoURL, rev, path, nil
+}
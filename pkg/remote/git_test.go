@@ -0,0 +1,63 @@
+package remote
+
+import "testing"
+
+func TestParseGitRef(t *testing.T) {
+	tests := []struct {
+		name        string
+		ref         string
+		wantRepoURL string
+		wantRev     string
+		wantPath    string
+		wantErr     bool
+	}{
+		{
+			name:        "https with path",
+			ref:         "git+https://github.com/org/shared-commands.git//path/commands.json",
+			wantRepoURL: "https://github.com/org/shared-commands.git",
+			wantPath:    "path/commands.json",
+		},
+		{
+			name:        "https with revision",
+			ref:         "git+https://github.com/org/shared-commands.git//commands.json@v2",
+			wantRepoURL: "https://github.com/org/shared-commands.git",
+			wantRev:     "v2",
+			wantPath:    "commands.json",
+		},
+		{
+			name:        "ssh with path",
+			ref:         "git+ssh://git@github.com/org/shared-commands.git//commands.json",
+			wantRepoURL: "ssh://git@github.com/org/shared-commands.git",
+			wantPath:    "commands.json",
+		},
+		{
+			name:    "missing path separator",
+			ref:     "git+https://github.com/org/shared-commands.git",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repoURL, rev, path, err := parseGitRef(tt.ref)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("parseGitRef(%q): expected error, got none", tt.ref)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("parseGitRef(%q): unexpected error: %v", tt.ref, err)
+			}
+			if repoURL != tt.wantRepoURL {
+				t.Errorf("repoURL = %q, want %q", repoURL, tt.wantRepoURL)
+			}
+			if rev != tt.wantRev {
+				t.Errorf("rev = %q, want %q", rev, tt.wantRev)
+			}
+			if path != tt.wantPath {
+				t.Errorf("path = %q, want %q", path, tt.wantPath)
+			}
+		})
+	}
+}
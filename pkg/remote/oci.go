@@ -0,0 +1,48 @@
+package remote
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+)
+
+// ociLoader resolves refs shaped like "oci://ghcr.io/org/commands:v1" by
+// pulling the artifact's single layer with oras and reading the extracted
+// file back out, the same manifest-resolve-then-fetch-blob flow ORAS uses
+// for any OCI artifact.
+type ociLoader struct{}
+
+func (ociLoader) Load(ref string) ([]byte, error) {
+	image := strings.TrimPrefix(ref, "oci://")
+
+	dir, err := os.MkdirTemp("", "distructions-oci-")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(dir)
+
+	if out, err := exec.Command("oras", "pull", image, "-o", dir).CombinedOutput(); err != nil {
+		return nil, fmt.Errorf("oras pull %s: %w: %s", image, err, out)
+	}
+
+	return readSingleFile(dir)
+}
+
+// readSingleFile returns the contents of the lone file in dir, which is the
+// layout an oras pull of a single-layer commands artifact produces.
+func readSingleFile(dir string) ([]byte, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			return os.ReadFile(filepath.Join(dir, entry.Name()))
+		}
+	}
+
+	return nil, fmt.Errorf("remote: no file found in pulled artifact")
+}
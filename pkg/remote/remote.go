@@ -0,0 +1,110 @@
+// Package remote resolves a shared .project-commands.json from a remote
+// source, mirroring the way Compose resolves git and OCI references for
+// its "include" directive.
+package remote
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// cacheTTL bounds how long a resolved ref is served from the on-disk cache
+// before Resolve re-fetches it. Without a TTL, a mutable ref (a moving OCI
+// tag, a branch name) would be cached forever on first fetch, which defeats
+// the "platform team updates the shared commands and everyone picks it up"
+// use case the extends mechanism exists for.
+const cacheTTL = 1 * time.Hour
+
+// Loader fetches the raw bytes of a commands file for a given reference.
+type Loader interface {
+	Load(ref string) ([]byte, error)
+}
+
+// loaders maps a reference scheme (the part before "://" or "+") to the
+// Loader responsible for it.
+var loaders = map[string]Loader{
+	"git": gitLoader{},
+	"oci": ociLoader{},
+}
+
+// Resolve fetches the commands file referenced by ref, using the on-disk
+// cache under CacheDir() when available and no older than cacheTTL. ref is
+// expected to look like
+// "git+https://github.com/org/shared-commands.git//path/commands.json" or
+// "oci://ghcr.io/org/commands:v1".
+func Resolve(ref string) ([]byte, error) {
+	scheme, err := schemeOf(ref)
+	if err != nil {
+		return nil, err
+	}
+
+	loader, ok := loaders[scheme]
+	if !ok {
+		return nil, fmt.Errorf("remote: unsupported scheme %q in ref %q", scheme, ref)
+	}
+
+	cachePath, err := cachedPath(ref)
+	if err == nil {
+		if info, statErr := os.Stat(cachePath); statErr == nil && time.Since(info.ModTime()) < cacheTTL {
+			if data, readErr := os.ReadFile(cachePath); readErr == nil {
+				return data, nil
+			}
+		}
+	}
+
+	data, err := loader.Load(ref)
+	if err != nil {
+		return nil, fmt.Errorf("remote: loading %q: %w", ref, err)
+	}
+
+	if cachePath != "" {
+		if mkErr := os.MkdirAll(filepath.Dir(cachePath), 0755); mkErr == nil {
+			_ = os.WriteFile(cachePath, data, 0644)
+		}
+	}
+
+	return data, nil
+}
+
+// schemeOf extracts the leading scheme from a ref such as "git+https://..."
+// or "oci://...".
+func schemeOf(ref string) (string, error) {
+	if idx := strings.Index(ref, "+"); idx != -1 {
+		return ref[:idx], nil
+	}
+	if idx := strings.Index(ref, "://"); idx != -1 {
+		return ref[:idx], nil
+	}
+	return "", fmt.Errorf("remote: could not determine scheme for ref %q", ref)
+}
+
+// CacheDir returns the directory remote fetches are cached under, honoring
+// XDG_CACHE_HOME like the rest of the XDG-aware tool ecosystem.
+func CacheDir() string {
+	base := os.Getenv("XDG_CACHE_HOME")
+	if base == "" {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			base = os.TempDir()
+		} else {
+			base = filepath.Join(home, ".cache")
+		}
+	}
+	return filepath.Join(base, "distructions")
+}
+
+// cachedPath returns the on-disk path a resolved ref is cached at, keyed by
+// its hash so two refs never collide.
+func cachedPath(ref string) (string, error) {
+	dir := CacheDir()
+	if dir == "" {
+		return "", fmt.Errorf("remote: no cache directory available")
+	}
+	sum := sha256.Sum256([]byte(ref))
+	return filepath.Join(dir, hex.EncodeToString(sum[:])+".json"), nil
+}
@@ -0,0 +1,34 @@
+package remote
+
+import "testing"
+
+func TestSchemeOf(t *testing.T) {
+	tests := []struct {
+		name    string
+		ref     string
+		want    string
+		wantErr bool
+	}{
+		{name: "git plus scheme", ref: "git+https://github.com/org/repo.git//commands.json", want: "git"},
+		{name: "oci scheme", ref: "oci://ghcr.io/org/commands:v1", want: "oci"},
+		{name: "no scheme", ref: "commands.json", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := schemeOf(tt.ref)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("schemeOf(%q): expected error, got none", tt.ref)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("schemeOf(%q): unexpected error: %v", tt.ref, err)
+			}
+			if got != tt.want {
+				t.Errorf("schemeOf(%q) = %q, want %q", tt.ref, got, tt.want)
+			}
+		})
+	}
+}
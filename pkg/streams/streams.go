@@ -0,0 +1,28 @@
+// Package streams provides an injectable set of I/O handles, following
+// Compose's api.Streams approach of threading Out/Err/In through commands
+// instead of reaching for os.Stdout/os.Stderr/os.Stdin directly. This is
+// what makes prompts, generator output, and the TUI's command execution
+// testable.
+package streams
+
+import (
+	"io"
+	"os"
+)
+
+// Streams bundles the input/output handles a command needs. Production code
+// uses NewStandard; tests can substitute buffers.
+type Streams struct {
+	Out io.Writer
+	Err io.Writer
+	In  io.Reader
+}
+
+// NewStandard returns a Streams wired to the process's real stdio.
+func NewStandard() Streams {
+	return Streams{
+		Out: os.Stdout,
+		Err: os.Stderr,
+		In:  os.Stdin,
+	}
+}
@@ -0,0 +1,434 @@
+// Package tui implements the Bubble Tea model distructions presents
+// interactively: a grouped, filterable, collapsible list of project
+// commands.
+package tui
+
+import (
+	"fmt"
+	"io"
+	"os/exec"
+	"sort"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/othernotherone/distructions/pkg/config"
+	"github.com/othernotherone/distructions/pkg/log"
+	"github.com/othernotherone/distructions/pkg/streams"
+)
+
+// Model represents the application state
+type Model struct {
+	config        config.Config
+	streams       streams.Streams
+	cursor        int
+	selected      map[int]struct{}
+	quitting      bool
+	err           error
+	collapsed     map[string]bool
+	activeProfile string
+
+	// Generation state, only set when the model starts in generating mode
+	// (first run, no .project-commands.json yet).
+	generating    bool
+	generator     *config.Generator
+	progressLines []string
+	progressCh    <-chan config.Progress
+	doneCh        <-chan error
+}
+
+// NewModel builds the initial Model for cfg, writing executed commands'
+// output to s instead of assuming os.Stdout/os.Stderr.
+func NewModel(cfg config.Config, err error, s streams.Streams) Model {
+	return Model{
+		config:    cfg,
+		streams:   s,
+		selected:  make(map[int]struct{}),
+		err:       err,
+		collapsed: make(map[string]bool),
+	}
+}
+
+// NewGeneratingModel builds a Model that, on Init, runs generator
+// concurrently across all its detectors and shows a live progress list
+// until generation finishes, then loads the resulting config.
+func NewGeneratingModel(generator *config.Generator, s streams.Streams) Model {
+	return Model{
+		streams:    s,
+		selected:   make(map[int]struct{}),
+		collapsed:  make(map[string]bool),
+		generating: true,
+		generator:  generator,
+	}
+}
+
+var (
+	// Colors
+	subtle    = lipgloss.AdaptiveColor{Light: "#D9DCCF", Dark: "#383838"}
+	highlight = lipgloss.AdaptiveColor{Light: "#874BFD", Dark: "#7D56F4"}
+	special   = lipgloss.AdaptiveColor{Light: "#43BF6D", Dark: "#73F59F"}
+
+	// Borders and boxes
+	boxStyle = lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(highlight).
+		Padding(1).
+		MarginBottom(1)
+
+	titleStyle = lipgloss.NewStyle().
+		Bold(true).
+		Foreground(special).
+		MarginLeft(2).
+		MarginBottom(1).
+		PaddingLeft(2).
+		SetString("✨ ")
+
+	itemStyle = lipgloss.NewStyle().
+		PaddingLeft(4)
+
+	selectedItemStyle = lipgloss.NewStyle().
+		Bold(true).
+		Foreground(highlight).
+		PaddingLeft(2)
+
+	descriptionStyle = lipgloss.NewStyle().
+		Foreground(subtle).
+		PaddingLeft(6)
+
+	groupHeaderStyle = lipgloss.NewStyle().
+		Bold(true).
+		Foreground(subtle).
+		PaddingLeft(2)
+
+	footerStyle = lipgloss.NewStyle().
+		Foreground(subtle).
+		Align(lipgloss.Center).
+		MarginTop(1)
+
+	// Icons for different command types
+	icons = map[string]string{
+		"npm":    "📦",
+		"docker": "🐳",
+		"go":     "🚀",
+		"test":   "🧪",
+		"build":  "🔨",
+		"run":    "▶️ ",
+		"deploy": "🚀",
+	}
+)
+
+func (m Model) Init() tea.Cmd {
+	if m.generating {
+		return beginGeneration(m.generator)
+	}
+	return nil
+}
+
+// generationStartedMsg carries the channels a just-launched generation run
+// reports its progress and final error on.
+type generationStartedMsg struct {
+	progress <-chan config.Progress
+	done     <-chan error
+}
+
+// progressMsg is one detector's status line, relayed from the generator.
+type progressMsg config.Progress
+
+// generationDoneMsg signals every detector has reported in and the config
+// file (if any commands were found) has been written.
+type generationDoneMsg struct {
+	err error
+}
+
+// beginGeneration kicks off generator.GenerateWithProgress on its own
+// goroutine and hands the model back the channels to listen on.
+func beginGeneration(generator *config.Generator) tea.Cmd {
+	return func() tea.Msg {
+		progress := make(chan config.Progress)
+		done := make(chan error, 1)
+		go func() {
+			done <- generator.GenerateWithProgress(false, progress)
+		}()
+		return generationStartedMsg{progress: progress, done: done}
+	}
+}
+
+// listenForProgress waits for the next progress line, or for the progress
+// channel to close (generation finished) in which case it reads the final
+// error off done.
+func listenForProgress(progress <-chan config.Progress, done <-chan error) tea.Cmd {
+	return func() tea.Msg {
+		p, ok := <-progress
+		if !ok {
+			return generationDoneMsg{err: <-done}
+		}
+		return progressMsg(p)
+	}
+}
+
+// visibleItem is one row of the rendered command list: either a collapsible
+// group header or a command belonging to the active profile filter.
+type visibleItem struct {
+	isHeader     bool
+	group        string
+	commandIndex int
+}
+
+// profiles returns the sorted, de-duplicated set of profiles declared across
+// all commands, used to drive the "p" filter hotkey.
+func (m Model) profiles() []string {
+	seen := map[string]bool{}
+	var profiles []string
+	for _, cmd := range m.config.Commands {
+		for _, p := range cmd.Profiles {
+			if !seen[p] {
+				seen[p] = true
+				profiles = append(profiles, p)
+			}
+		}
+	}
+	sort.Strings(profiles)
+	return profiles
+}
+
+// matchesActiveProfile reports whether cmd should be shown under the current
+// profile filter. Commands with no profiles are always shown.
+func (m Model) matchesActiveProfile(cmd config.Command) bool {
+	if m.activeProfile == "" || len(cmd.Profiles) == 0 {
+		return true
+	}
+	for _, p := range cmd.Profiles {
+		if p == m.activeProfile {
+			return true
+		}
+	}
+	return false
+}
+
+// visibleItems builds the flattened, filtered, collapse-aware list of rows
+// to render. Grouped commands are nested under a header row that can be
+// toggled; ungrouped commands are always shown inline.
+func (m Model) visibleItems() []visibleItem {
+	var items []visibleItem
+	seenGroups := map[string]bool{}
+
+	for i, cmd := range m.config.Commands {
+		if !m.matchesActiveProfile(cmd) {
+			continue
+		}
+
+		if cmd.Group == "" {
+			items = append(items, visibleItem{commandIndex: i})
+			continue
+		}
+
+		if !seenGroups[cmd.Group] {
+			seenGroups[cmd.Group] = true
+			items = append(items, visibleItem{isHeader: true, group: cmd.Group})
+		}
+
+		if !m.collapsed[cmd.Group] {
+			items = append(items, visibleItem{commandIndex: i})
+		}
+	}
+
+	return items
+}
+
+func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case generationStartedMsg:
+		m.progressCh = msg.progress
+		m.doneCh = msg.done
+		return m, listenForProgress(m.progressCh, m.doneCh)
+
+	case progressMsg:
+		m.progressLines = append(m.progressLines, fmt.Sprintf("%s: %s", msg.Detector, msg.Message))
+		return m, listenForProgress(m.progressCh, m.doneCh)
+
+	case generationDoneMsg:
+		m.generating = false
+		if msg.err != nil {
+			m.err = msg.err
+			return m, nil
+		}
+		cfg, err := config.ReadLocal()
+		m.config = cfg
+		m.err = err
+		return m, nil
+
+	case tea.KeyMsg:
+		if m.generating {
+			if msg.String() == "ctrl+c" || msg.String() == "q" {
+				m.quitting = true
+				return m, tea.Quit
+			}
+			return m, nil
+		}
+		items := m.visibleItems()
+		switch msg.String() {
+		case "ctrl+c", "q":
+			m.quitting = true
+			return m, tea.Quit
+		case "up", "k":
+			if m.cursor > 0 {
+				m.cursor--
+			}
+		case "down", "j":
+			if m.cursor < len(items)-1 {
+				m.cursor++
+			}
+		case "p":
+			profiles := m.profiles()
+			if len(profiles) == 0 {
+				break
+			}
+			m.activeProfile = nextProfile(profiles, m.activeProfile)
+			m.cursor = 0
+		case "enter":
+			if m.cursor >= len(items) {
+				break
+			}
+			item := items[m.cursor]
+			if item.isHeader {
+				m.collapsed[item.group] = !m.collapsed[item.group]
+				break
+			}
+			cmd := m.config.Commands[item.commandIndex].Command
+			return m, m.runCommand(cmd)
+		}
+	}
+	return m, nil
+}
+
+// runCommand executes cmdStr as bubbletea normally would, but also tees its
+// stdout/stderr into the log along with duration and exit code so a failing
+// script can be debugged after the TUI has quit.
+func (m Model) runCommand(cmdStr string) tea.Cmd {
+	cmd := exec.Command("sh", "-c", cmdStr)
+	start := time.Now()
+
+	var output strings.Builder
+	cmd.Stdout = io.MultiWriter(m.streams.Out, &output)
+	cmd.Stderr = io.MultiWriter(m.streams.Err, &output)
+
+	return tea.ExecProcess(cmd, func(err error) tea.Msg {
+		duration := time.Since(start)
+		exitCode := 0
+		if err != nil {
+			exitCode = -1
+			if exitErr, ok := err.(*exec.ExitError); ok {
+				exitCode = exitErr.ExitCode()
+			}
+		}
+		log.Infof("ran %q in %s (exit %d): %s", cmdStr, duration, exitCode, output.String())
+		return nil
+	})
+}
+
+// nextProfile cycles through "" (no filter) followed by every known profile.
+func nextProfile(profiles []string, current string) string {
+	all := append([]string{""}, profiles...)
+	for i, p := range all {
+		if p == current {
+			return all[(i+1)%len(all)]
+		}
+	}
+	return ""
+}
+
+func (m Model) View() string {
+	if m.err != nil {
+		return boxStyle.Render(fmt.Sprintf("Error: %v", m.err))
+	}
+
+	if m.quitting {
+		return "Goodbye! 👋\n"
+	}
+
+	if m.generating {
+		return m.viewGenerating()
+	}
+
+	// Build the title section
+	title := titleStyle.Render(m.config.ProjectName)
+
+	// Build the commands section
+	var commands strings.Builder
+	for i, item := range m.visibleItems() {
+		if item.isHeader {
+			arrow := "▾"
+			if m.collapsed[item.group] {
+				arrow = "▸"
+			}
+			commands.WriteString(groupHeaderStyle.Render(
+				fmt.Sprintf("%s %s", arrow, item.group),
+			))
+			commands.WriteString("\n")
+			continue
+		}
+
+		cmd := m.config.Commands[item.commandIndex]
+
+		// Determine the icon based on command name
+		icon := "💫" // default icon
+		for key, specificIcon := range icons {
+			if strings.Contains(strings.ToLower(cmd.Name), key) {
+				icon = specificIcon
+				break
+			}
+		}
+
+		// Style the command entry
+		cursor := " "
+		if m.cursor == i {
+			cursor = "→"
+			commands.WriteString(selectedItemStyle.Render(
+				fmt.Sprintf("%s %s %s", cursor, icon, cmd.Name),
+			))
+		} else {
+			commands.WriteString(itemStyle.Render(
+				fmt.Sprintf("%s %s %s", cursor, icon, cmd.Name),
+			))
+		}
+		commands.WriteString("\n")
+
+		// Add description with subtle styling
+		commands.WriteString(descriptionStyle.Render(cmd.Description))
+		commands.WriteString("\n\n")
+	}
+
+	// Build the footer
+	footerText := "↑/↓: navigate • enter: run/expand • q: quit"
+	if profiles := m.profiles(); len(profiles) > 0 {
+		activeLabel := m.activeProfile
+		if activeLabel == "" {
+			activeLabel = "all"
+		}
+		footerText += fmt.Sprintf(" • p: filter profile (%s)", activeLabel)
+	}
+	footer := footerStyle.Render(footerText)
+
+	// Combine all sections in a box
+	content := fmt.Sprintf("%s\n%s\n%s", title, commands.String(), footer)
+	return boxStyle.Render(content)
+}
+
+// viewGenerating renders the live per-detector status list shown while
+// first-run generation is in progress.
+func (m Model) viewGenerating() string {
+	title := titleStyle.Render("Generating .project-commands.json")
+
+	var lines strings.Builder
+	for _, line := range m.progressLines {
+		lines.WriteString(itemStyle.Render(line))
+		lines.WriteString("\n")
+	}
+
+	footer := footerStyle.Render("q: quit")
+
+	content := fmt.Sprintf("%s\n%s\n%s", title, lines.String(), footer)
+	return boxStyle.Render(content)
+}